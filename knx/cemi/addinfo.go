@@ -0,0 +1,253 @@
+package cemi
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// AddInfoType identifies the kind of data carried by an AddInfoEntry.
+type AddInfoType uint8
+
+const (
+	// AddInfoPLMedium identifies PL medium information.
+	AddInfoPLMedium AddInfoType = 0x01
+
+	// AddInfoRFMedium identifies RF medium information.
+	AddInfoRFMedium AddInfoType = 0x02
+
+	// AddInfoBusmonStatus identifies busmonitor status information.
+	AddInfoBusmonStatus AddInfoType = 0x03
+
+	// AddInfoRelTimestamp identifies a relative timestamp.
+	AddInfoRelTimestamp AddInfoType = 0x04
+
+	// AddInfoTimeDelaySend identifies the time delay until sending.
+	AddInfoTimeDelaySend AddInfoType = 0x05
+
+	// AddInfoExtRelTimestamp identifies an extended relative timestamp.
+	AddInfoExtRelTimestamp AddInfoType = 0x06
+
+	// AddInfoBiBatInfo identifies BiBat information.
+	AddInfoBiBatInfo AddInfoType = 0x07
+)
+
+// String converts the additional info type to a string.
+func (t AddInfoType) String() string {
+	switch t {
+	case AddInfoPLMedium:
+		return "PLMedium"
+
+	case AddInfoRFMedium:
+		return "RFMedium"
+
+	case AddInfoBusmonStatus:
+		return "BusmonStatus"
+
+	case AddInfoRelTimestamp:
+		return "RelTimestamp"
+
+	case AddInfoTimeDelaySend:
+		return "TimeDelaySend"
+
+	case AddInfoExtRelTimestamp:
+		return "ExtRelTimestamp"
+
+	case AddInfoBiBatInfo:
+		return "BiBatInfo"
+
+	default:
+		return fmt.Sprintf("%#x", uint8(t))
+	}
+}
+
+// AddInfoEntry is a single (type, length, value) record inside an Additional
+// Information block.
+type AddInfoEntry struct {
+	Type AddInfoType
+	Data []byte
+}
+
+// AddInfo is the additional info segment of a CEMI-encoded frame. It is a
+// sequence of typed TLV entries as described in the CEMI specification.
+type AddInfo []AddInfoEntry
+
+// Find returns the first entry of the given type, if any.
+func (info AddInfo) Find(t AddInfoType) (AddInfoEntry, bool) {
+	for _, entry := range info {
+		if entry.Type == t {
+			return entry, true
+		}
+	}
+
+	return AddInfoEntry{}, false
+}
+
+// BusmonStatusInfo is the decoded payload of an AddInfoBusmonStatus entry.
+type BusmonStatusInfo struct {
+	FrameError  bool
+	BitError    bool
+	ParityError bool
+	Overflow    bool
+	Lost        bool
+	SequenceNum uint8
+}
+
+// BusmonStatus looks up and decodes the busmonitor status entry, if present.
+func (info AddInfo) BusmonStatus() (BusmonStatusInfo, bool) {
+	entry, ok := info.Find(AddInfoBusmonStatus)
+	if !ok || len(entry.Data) < 1 {
+		return BusmonStatusInfo{}, false
+	}
+
+	b := entry.Data[0]
+
+	return BusmonStatusInfo{
+		FrameError:  b&0x80 != 0,
+		BitError:    b&0x40 != 0,
+		ParityError: b&0x20 != 0,
+		Overflow:    b&0x10 != 0,
+		Lost:        b&0x08 != 0,
+		SequenceNum: b & 0x07,
+	}, true
+}
+
+// RelTimestamp is the decoded payload of an AddInfoRelTimestamp entry: a
+// timestamp in milliseconds, relative to the reception of the frame.
+type RelTimestamp uint16
+
+// RelTimestamp looks up and decodes the relative timestamp entry, if present.
+func (info AddInfo) RelTimestamp() (RelTimestamp, bool) {
+	entry, ok := info.Find(AddInfoRelTimestamp)
+	if !ok || len(entry.Data) < 2 {
+		return 0, false
+	}
+
+	return RelTimestamp(uint16(entry.Data[0])<<8 | uint16(entry.Data[1])), true
+}
+
+// RFMediumInfo is the decoded payload of an AddInfoRFMedium entry.
+type RFMediumInfo struct {
+	// RSS is the received signal strength, as reported by the RF medium.
+	RSS int8
+
+	// Repeated is true, if the frame is a repeated transmission.
+	Repeated bool
+}
+
+// RFMediumInfo looks up and decodes the RF medium information entry, if present.
+func (info AddInfo) RFMediumInfo() (RFMediumInfo, bool) {
+	entry, ok := info.Find(AddInfoRFMedium)
+	if !ok || len(entry.Data) < 2 {
+		return RFMediumInfo{}, false
+	}
+
+	return RFMediumInfo{
+		RSS:      int8(entry.Data[0]),
+		Repeated: entry.Data[1]&0x20 != 0,
+	}, true
+}
+
+// truncatedSize returns how many leading entries of info fit within the
+// 255-byte Additional Information length field, and the total byte length of
+// their TLV encoding. Size and Pack share this so that Pack never writes more
+// than Size reports.
+func (info AddInfo) truncatedSize() (count int, n uint) {
+	for _, entry := range info {
+		entryLen := 2 + uint(len(entry.Data))
+		if n+entryLen > 255 {
+			break
+		}
+
+		n += entryLen
+		count++
+	}
+
+	return
+}
+
+// Size returns the packed size, including the leading total-length byte.
+func (info AddInfo) Size() uint {
+	_, n := info.truncatedSize()
+	return 1 + n
+}
+
+// Pack the additional information block into the buffer. Entries beyond the
+// 255-byte Additional Information length field are silently dropped, as
+// reflected by Size.
+func (info AddInfo) Pack(buffer []byte) {
+	count, n := info.truncatedSize()
+	buffer[0] = byte(n)
+
+	pos := uint(1)
+
+	for _, entry := range info[:count] {
+		buffer[pos] = byte(entry.Type)
+		buffer[pos+1] = byte(len(entry.Data))
+		pos += 2
+		pos += uint(copy(buffer[pos:], entry.Data))
+	}
+}
+
+// Unpack initializes the structure by parsing the given data. The length
+// byte is read directly, rather than through util.Unpack, so that this step
+// itself does not allocate.
+func (info *AddInfo) Unpack(data []byte) (n uint, err error) {
+	if len(data) < 1 {
+		return 0, errors.New("cemi: buffer too short for additional info length")
+	}
+
+	length := data[0]
+	n = 1
+
+	end := n + uint(length)
+	if uint(len(data)) < end {
+		return n, errors.New("cemi: additional info block exceeds given buffer")
+	}
+
+	// Reuse previously allocated entries (and their Data backing arrays, via
+	// reslice) when info already holds some, e.g. because it came from a
+	// Decoder's pool.
+	reusable := *info
+	entries := reusable[:0]
+
+	for n < end {
+		if end-n < 2 {
+			return n, errors.New("cemi: truncated additional info entry")
+		}
+
+		entryType := AddInfoType(data[n])
+		entryLen := uint(data[n+1])
+		n += 2
+
+		if end-n < entryLen {
+			return n, errors.New("cemi: truncated additional info entry")
+		}
+
+		var value []byte
+		if idx := len(entries); idx < cap(reusable) {
+			value = append(reusable[:cap(reusable)][idx].Data[:0], data[n:n+entryLen]...)
+		} else {
+			value = make([]byte, entryLen)
+			copy(value, data[n:n+entryLen])
+		}
+
+		n += entryLen
+
+		entries = append(entries, AddInfoEntry{Type: entryType, Data: value})
+	}
+
+	*info = entries
+
+	return n, nil
+}
+
+// WriteTo writes an additional information segment.
+func (info AddInfo) WriteTo(w io.Writer) (int64, error) {
+	buffer := make([]byte, info.Size())
+	info.Pack(buffer)
+
+	written, err := w.Write(buffer)
+
+	return int64(written), err
+}
@@ -34,8 +34,36 @@ const (
 	// LRawConCode is the message code for L_Raw.con.
 	LRawConCode MessageCode = 0x2F
 
-	// LPollDataReqCode MessageCode = 0x13
-	// LPollDataConCode MessageCode = 0x25
+	// LPollDataReqCode is the message code for L_Poll_Data.req.
+	LPollDataReqCode MessageCode = 0x13
+
+	// LPollDataConCode is the message code for L_Poll_Data.con.
+	LPollDataConCode MessageCode = 0x25
+
+	// MPropReadReqCode is the message code for M_PropRead.req.
+	MPropReadReqCode MessageCode = 0xFC
+
+	// MPropReadConCode is the message code for M_PropRead.con.
+	MPropReadConCode MessageCode = 0xFB
+
+	// MPropWriteReqCode is the message code for M_PropWrite.req.
+	MPropWriteReqCode MessageCode = 0xF6
+
+	// MPropWriteConCode is the message code for M_PropWrite.con.
+	MPropWriteConCode MessageCode = 0xF5
+
+	// MPropInfoIndCode is the message code for M_PropInfo.ind.
+	MPropInfoIndCode MessageCode = 0xF7
+
+	// MFuncPropCommandReqCode is the message code for M_FuncPropCommand.req.
+	MFuncPropCommandReqCode MessageCode = 0xF8
+
+	// MFuncPropCommandConCode is the message code for M_FuncPropCommand.con.
+	MFuncPropCommandConCode MessageCode = 0xF9
+
+	// MResetReqCode is the message code for M_Reset.req and M_Reset.ind, which
+	// share the same code and are distinguished by the direction of transmission.
+	MResetReqCode MessageCode = 0xF1
 )
 
 // String converts the message code to a string.
@@ -62,12 +90,44 @@ func (code MessageCode) String() string {
 	case LRawConCode:
 		return "LRawCon"
 
+	case LPollDataReqCode:
+		return "LPollDataReq"
+
+	case LPollDataConCode:
+		return "LPollDataCon"
+
+	case MPropReadReqCode:
+		return "MPropReadReq"
+
+	case MPropReadConCode:
+		return "MPropReadCon"
+
+	case MPropWriteReqCode:
+		return "MPropWriteReq"
+
+	case MPropWriteConCode:
+		return "MPropWriteCon"
+
+	case MPropInfoIndCode:
+		return "MPropInfoInd"
+
+	case MFuncPropCommandReqCode:
+		return "MFuncPropCommandReq"
+
+	case MFuncPropCommandConCode:
+		return "MFuncPropCommandCon"
+
+	case MResetReqCode:
+		return "MReset"
+
 	default:
 		return fmt.Sprintf("%#x", uint8(code))
 	}
 }
 
-// Info is the additional info segment of a CEMI-encoded frame.
+// Info is the additional info segment of a CEMI-encoded frame, kept for
+// existing callers that still construct or inspect it directly. New code
+// should prefer the structured AddInfo type.
 type Info []byte
 
 // Size returns the packed size.
@@ -146,11 +206,8 @@ func (body *UnsupportedMessage) MessageCode() MessageCode {
 
 // Unpack initializes the structure by parsing the given data.
 func (body *UnsupportedMessage) Unpack(data []byte) (uint, error) {
-	if len(body.Data) < len(data) {
-		body.Data = make([]byte, len(data))
-	}
-
-	return uint(copy(body.Data, data)), nil
+	body.Data = append(body.Data[:0], data...)
+	return uint(len(data)), nil
 }
 
 // WriteTo serializes the structure and writes it to the given Writer.
@@ -164,53 +221,19 @@ type messageUnpackable interface {
 	Message
 }
 
-// Unpack a message from a CEMI-encoded frame.
+// Unpack a message from a CEMI-encoded frame. It delegates to a shared
+// default Decoder. Call Release once the Message is no longer needed to
+// return its buffers to that Decoder's pools; without a matching Release,
+// Unpack allocates a fresh Message on every call, exactly as it always has.
 func Unpack(data []byte, message *Message) (n uint, err error) {
-	var code MessageCode
-
-	// Read header.
-	n, err = util.Unpack(data, (*uint8)(&code))
-	if err != nil {
-		return
-	}
-
-	var body messageUnpackable
-
-	// Decide which message is appropriate.
-	switch code {
-	case LBusmonIndCode:
-		body = &LBusmonInd{}
-
-	case LDataReqCode:
-		body = &LDataReq{}
-
-	case LDataConCode:
-		body = &LDataCon{}
-
-	case LDataIndCode:
-		body = &LDataInd{}
-
-	case LRawReqCode:
-		body = &LRawReq{}
-
-	case LRawConCode:
-		body = &LRawCon{}
-
-	case LRawIndCode:
-		body = &LRawInd{}
-
-	default:
-		body = &UnsupportedMessage{Code: code}
-	}
-
-	// Parse the message.
-	m, err := body.Unpack(data[n:])
-
-	if err == nil {
-		*message = body
-	}
+	return defaultDecoder.Unpack(data, message)
+}
 
-	return n + m, err
+// Release returns the backing buffers of a Message obtained from the
+// package-level Unpack to the default Decoder's pools. The message must not
+// be used afterwards.
+func Release(message Message) {
+	defaultDecoder.Release(message)
 }
 
 // Size returns the size for a CEMI-encoded frame with the given message.
@@ -0,0 +1,137 @@
+package cemi
+
+import (
+	"errors"
+	"sync"
+)
+
+// A Decoder unpacks cEMI-encoded frames. A Decoder (including the default one
+// behind the package-level Unpack) pools the backing buffers of the message
+// types it sees most often
+// on a busy bus (L_Busmon.ind, L_Data.ind and unsupported messages), so that
+// repeated calls to Unpack do not need to allocate once the pool is warmed
+// up. Call Release once a decoded Message is no longer needed to return its
+// buffers to the pool.
+//
+// A Decoder is safe for concurrent use.
+type Decoder struct {
+	busmonPool  sync.Pool
+	dataIndPool sync.Pool
+	unsupPool   sync.Pool
+}
+
+// NewDecoder creates a ready to use Decoder.
+func NewDecoder() *Decoder {
+	return &Decoder{
+		busmonPool:  sync.Pool{New: func() interface{} { return new(LBusmonInd) }},
+		dataIndPool: sync.Pool{New: func() interface{} { return new(LDataInd) }},
+		unsupPool:   sync.Pool{New: func() interface{} { return new(UnsupportedMessage) }},
+	}
+}
+
+// defaultDecoder backs the package-level Unpack function.
+var defaultDecoder = NewDecoder()
+
+// Unpack a message from a CEMI-encoded frame. When the frame holds a message
+// type that d pools (currently L_Busmon.ind, L_Data.ind and unsupported
+// messages), the returned Message draws its backing buffers from that pool
+// instead of allocating new ones. The header byte is read directly, rather
+// than through util.Unpack, so that this step itself does not allocate.
+func (d *Decoder) Unpack(data []byte, message *Message) (n uint, err error) {
+	if len(data) < 1 {
+		return 0, errors.New("cemi: buffer too short for message code")
+	}
+
+	code := MessageCode(data[0])
+	n = 1
+
+	var body messageUnpackable
+
+	// Decide which message is appropriate.
+	switch code {
+	case LBusmonIndCode:
+		body = d.busmonPool.Get().(*LBusmonInd)
+
+	case LDataReqCode:
+		body = &LDataReq{}
+
+	case LDataConCode:
+		body = &LDataCon{}
+
+	case LDataIndCode:
+		body = d.dataIndPool.Get().(*LDataInd)
+
+	case LRawReqCode:
+		body = &LRawReq{}
+
+	case LRawConCode:
+		body = &LRawCon{}
+
+	case LRawIndCode:
+		body = &LRawInd{}
+
+	case LPollDataReqCode:
+		body = &LPollDataReq{}
+
+	case LPollDataConCode:
+		body = &LPollDataCon{}
+
+	case MPropReadReqCode:
+		body = &MPropReadReq{}
+
+	case MPropReadConCode:
+		body = &MPropReadCon{}
+
+	case MPropWriteReqCode:
+		body = &MPropWriteReq{}
+
+	case MPropWriteConCode:
+		body = &MPropWriteCon{}
+
+	case MPropInfoIndCode:
+		body = &MPropInfoInd{}
+
+	case MFuncPropCommandReqCode:
+		body = &MFuncPropCommandReq{}
+
+	case MFuncPropCommandConCode:
+		body = &MFuncPropCommandCon{}
+
+	case MResetReqCode:
+		body = &MReset{}
+
+	default:
+		um := d.unsupPool.Get().(*UnsupportedMessage)
+		um.Code = code
+		body = um
+	}
+
+	// Parse the message.
+	m, err := body.Unpack(data[n:])
+
+	if err == nil {
+		*message = body
+	}
+
+	return n + m, err
+}
+
+// Release returns the backing buffers of message to d's pools, if message is
+// of a type d pools. The message must not be used afterwards.
+func (d *Decoder) Release(message Message) {
+	switch msg := message.(type) {
+	case *LBusmonInd:
+		msg.AddInfo = msg.AddInfo[:0]
+		msg.Info = msg.Info[:0]
+		d.busmonPool.Put(msg)
+
+	case *LDataInd:
+		msg.AddInfo = msg.AddInfo[:0]
+		msg.Data = msg.Data[:0]
+		d.dataIndPool.Put(msg)
+
+	case *UnsupportedMessage:
+		msg.Data = msg.Data[:0]
+		d.unsupPool.Put(msg)
+	}
+}
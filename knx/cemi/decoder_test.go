@@ -0,0 +1,51 @@
+package cemi
+
+import "testing"
+
+// busmonFrame builds a raw L_Busmon.ind frame with no additional info and a
+// 64-byte monitor payload, representative of a frame seen on a busy bus.
+func busmonFrame() []byte {
+	frame := make([]byte, 2+64)
+	frame[0] = byte(LBusmonIndCode)
+	frame[1] = 0
+
+	for i := range frame[2:] {
+		frame[2+i] = byte(i)
+	}
+
+	return frame
+}
+
+// BenchmarkUnpack benchmarks the package-level Unpack on a stream of
+// L_Busmon.ind frames. Since nothing calls Release on the shared default
+// Decoder, every frame still allocates a fresh LBusmonInd and backing array,
+// matching the behaviour before the Decoder was introduced.
+func BenchmarkUnpack(b *testing.B) {
+	frame := busmonFrame()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var msg Message
+		if _, err := Unpack(frame, &msg); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkDecoderUnpack benchmarks a dedicated Decoder whose buffers are
+// returned via Release after every frame, the steady-state allocation-free
+// path intended for busmon-heavy workloads.
+func BenchmarkDecoderUnpack(b *testing.B) {
+	frame := busmonFrame()
+	dec := NewDecoder()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var msg Message
+		if _, err := dec.Unpack(frame, &msg); err != nil {
+			b.Fatal(err)
+		}
+
+		dec.Release(msg)
+	}
+}
@@ -3,7 +3,14 @@ package cemi
 import "io"
 
 // A LBusmonInd represents a L_Busmon.ind message.
-type LBusmonInd []byte
+type LBusmonInd struct {
+	// AddInfo is the additional information block prefixed to the monitor frame,
+	// e.g. the busmonitor status, RSSI or timestamp of the captured frame.
+	AddInfo AddInfo
+
+	// Info is the raw monitor frame as it was captured on the bus.
+	Info []byte
+}
 
 // MessageCode returns the message code for L_Busmon.ind.
 func (LBusmonInd) MessageCode() MessageCode {
@@ -12,30 +19,40 @@ func (LBusmonInd) MessageCode() MessageCode {
 
 // WriteTo serializes the structure and writes it to the given Writer.
 func (lbm *LBusmonInd) WriteTo(w io.Writer) (int64, error) {
-	len, err := w.Write([]byte(*lbm))
-	return int64(len), err
+	addLen, err := lbm.AddInfo.WriteTo(w)
+	if err != nil {
+		return addLen, err
+	}
+
+	n, err := w.Write(lbm.Info)
+
+	return addLen + int64(n), err
 }
 
 // Size returns the packed size.
 func (lbm LBusmonInd) Size() uint {
-	return uint(len(lbm))
+	return lbm.AddInfo.Size() + uint(len(lbm.Info))
 }
 
 // Pack the message body into the buffer.
 func (lbm LBusmonInd) Pack(buffer []byte) {
-	copy(buffer, lbm)
+	addLen := lbm.AddInfo.Size()
+	lbm.AddInfo.Pack(buffer[:addLen])
+	copy(buffer[addLen:], lbm.Info)
 }
 
-// Unpack initializes the structure by parsing the given data.
+// Unpack initializes the structure by parsing the given data. If Info and
+// AddInfo already have sufficient capacity (e.g. because the LBusmonInd came
+// from a Decoder's pool), no allocation is performed.
 func (lbm *LBusmonInd) Unpack(data []byte) (n uint, err error) {
-	target := []byte(*lbm)
-
-	if len(target) < len(data) {
-		target = make([]byte, len(data))
+	n, err = lbm.AddInfo.Unpack(data)
+	if err != nil {
+		return
 	}
 
-	n = uint(copy(target, data))
-	*lbm = LBusmonInd(target)
+	rest := data[n:]
+	lbm.Info = append(lbm.Info[:0], rest...)
+	n += uint(len(rest))
 
 	return
 }
@@ -0,0 +1,322 @@
+package cemi
+
+import "io"
+
+// A LDataReq represents a L_Data.req message.
+type LDataReq struct {
+	// AddInfo is the additional information block prefixed to the frame.
+	AddInfo AddInfo
+
+	// Data is the service-specific part of the frame: control fields, source
+	// and destination address, and the transport-layer payload.
+	Data []byte
+}
+
+// MessageCode returns the message code for L_Data.req.
+func (LDataReq) MessageCode() MessageCode {
+	return LDataReqCode
+}
+
+// Size returns the packed size.
+func (req LDataReq) Size() uint {
+	return req.AddInfo.Size() + uint(len(req.Data))
+}
+
+// Pack the message body into the buffer.
+func (req LDataReq) Pack(buffer []byte) {
+	addLen := req.AddInfo.Size()
+	req.AddInfo.Pack(buffer[:addLen])
+	copy(buffer[addLen:], req.Data)
+}
+
+// Unpack initializes the structure by parsing the given data.
+func (req *LDataReq) Unpack(data []byte) (n uint, err error) {
+	n, err = req.AddInfo.Unpack(data)
+	if err != nil {
+		return
+	}
+
+	rest := data[n:]
+	req.Data = append(req.Data[:0], rest...)
+	n += uint(len(rest))
+
+	return
+}
+
+// WriteTo serializes the structure and writes it to the given Writer.
+func (req *LDataReq) WriteTo(w io.Writer) (int64, error) {
+	addLen, err := req.AddInfo.WriteTo(w)
+	if err != nil {
+		return addLen, err
+	}
+
+	n, err := w.Write(req.Data)
+
+	return addLen + int64(n), err
+}
+
+// A LDataCon represents a L_Data.con message.
+type LDataCon struct {
+	// AddInfo is the additional information block prefixed to the frame.
+	AddInfo AddInfo
+
+	// Data is the service-specific part of the frame: control fields, source
+	// and destination address, and the transport-layer payload.
+	Data []byte
+}
+
+// MessageCode returns the message code for L_Data.con.
+func (LDataCon) MessageCode() MessageCode {
+	return LDataConCode
+}
+
+// Size returns the packed size.
+func (con LDataCon) Size() uint {
+	return con.AddInfo.Size() + uint(len(con.Data))
+}
+
+// Pack the message body into the buffer.
+func (con LDataCon) Pack(buffer []byte) {
+	addLen := con.AddInfo.Size()
+	con.AddInfo.Pack(buffer[:addLen])
+	copy(buffer[addLen:], con.Data)
+}
+
+// Unpack initializes the structure by parsing the given data.
+func (con *LDataCon) Unpack(data []byte) (n uint, err error) {
+	n, err = con.AddInfo.Unpack(data)
+	if err != nil {
+		return
+	}
+
+	rest := data[n:]
+	con.Data = append(con.Data[:0], rest...)
+	n += uint(len(rest))
+
+	return
+}
+
+// WriteTo serializes the structure and writes it to the given Writer.
+func (con *LDataCon) WriteTo(w io.Writer) (int64, error) {
+	addLen, err := con.AddInfo.WriteTo(w)
+	if err != nil {
+		return addLen, err
+	}
+
+	n, err := w.Write(con.Data)
+
+	return addLen + int64(n), err
+}
+
+// A LDataInd represents a L_Data.ind message.
+type LDataInd struct {
+	// AddInfo is the additional information block prefixed to the frame. On
+	// the bus, this is where a device carries e.g. RF medium information or a
+	// relative timestamp alongside the indicated frame.
+	AddInfo AddInfo
+
+	// Data is the service-specific part of the frame: control fields, source
+	// and destination address, and the transport-layer payload.
+	Data []byte
+}
+
+// MessageCode returns the message code for L_Data.ind.
+func (LDataInd) MessageCode() MessageCode {
+	return LDataIndCode
+}
+
+// Size returns the packed size.
+func (ind LDataInd) Size() uint {
+	return ind.AddInfo.Size() + uint(len(ind.Data))
+}
+
+// Pack the message body into the buffer.
+func (ind LDataInd) Pack(buffer []byte) {
+	addLen := ind.AddInfo.Size()
+	ind.AddInfo.Pack(buffer[:addLen])
+	copy(buffer[addLen:], ind.Data)
+}
+
+// Unpack initializes the structure by parsing the given data. The leading
+// Additional Info block is parsed into AddInfo, so callers can read e.g.
+// RFMediumInfo() or RelTimestamp() off it without re-parsing the raw buffer.
+func (ind *LDataInd) Unpack(data []byte) (n uint, err error) {
+	n, err = ind.AddInfo.Unpack(data)
+	if err != nil {
+		return
+	}
+
+	rest := data[n:]
+	ind.Data = append(ind.Data[:0], rest...)
+	n += uint(len(rest))
+
+	return
+}
+
+// WriteTo serializes the structure and writes it to the given Writer.
+func (ind *LDataInd) WriteTo(w io.Writer) (int64, error) {
+	addLen, err := ind.AddInfo.WriteTo(w)
+	if err != nil {
+		return addLen, err
+	}
+
+	n, err := w.Write(ind.Data)
+
+	return addLen + int64(n), err
+}
+
+// A LRawReq represents a L_Raw.req message.
+type LRawReq struct {
+	// AddInfo is the additional information block prefixed to the frame.
+	AddInfo AddInfo
+
+	// Data is the raw frame to be sent onto the medium.
+	Data []byte
+}
+
+// MessageCode returns the message code for L_Raw.req.
+func (LRawReq) MessageCode() MessageCode {
+	return LRawReqCode
+}
+
+// Size returns the packed size.
+func (req LRawReq) Size() uint {
+	return req.AddInfo.Size() + uint(len(req.Data))
+}
+
+// Pack the message body into the buffer.
+func (req LRawReq) Pack(buffer []byte) {
+	addLen := req.AddInfo.Size()
+	req.AddInfo.Pack(buffer[:addLen])
+	copy(buffer[addLen:], req.Data)
+}
+
+// Unpack initializes the structure by parsing the given data.
+func (req *LRawReq) Unpack(data []byte) (n uint, err error) {
+	n, err = req.AddInfo.Unpack(data)
+	if err != nil {
+		return
+	}
+
+	rest := data[n:]
+	req.Data = append(req.Data[:0], rest...)
+	n += uint(len(rest))
+
+	return
+}
+
+// WriteTo serializes the structure and writes it to the given Writer.
+func (req *LRawReq) WriteTo(w io.Writer) (int64, error) {
+	addLen, err := req.AddInfo.WriteTo(w)
+	if err != nil {
+		return addLen, err
+	}
+
+	n, err := w.Write(req.Data)
+
+	return addLen + int64(n), err
+}
+
+// A LRawCon represents a L_Raw.con message.
+type LRawCon struct {
+	// AddInfo is the additional information block prefixed to the frame.
+	AddInfo AddInfo
+
+	// Data is the raw frame that was sent onto the medium.
+	Data []byte
+}
+
+// MessageCode returns the message code for L_Raw.con.
+func (LRawCon) MessageCode() MessageCode {
+	return LRawConCode
+}
+
+// Size returns the packed size.
+func (con LRawCon) Size() uint {
+	return con.AddInfo.Size() + uint(len(con.Data))
+}
+
+// Pack the message body into the buffer.
+func (con LRawCon) Pack(buffer []byte) {
+	addLen := con.AddInfo.Size()
+	con.AddInfo.Pack(buffer[:addLen])
+	copy(buffer[addLen:], con.Data)
+}
+
+// Unpack initializes the structure by parsing the given data.
+func (con *LRawCon) Unpack(data []byte) (n uint, err error) {
+	n, err = con.AddInfo.Unpack(data)
+	if err != nil {
+		return
+	}
+
+	rest := data[n:]
+	con.Data = append(con.Data[:0], rest...)
+	n += uint(len(rest))
+
+	return
+}
+
+// WriteTo serializes the structure and writes it to the given Writer.
+func (con *LRawCon) WriteTo(w io.Writer) (int64, error) {
+	addLen, err := con.AddInfo.WriteTo(w)
+	if err != nil {
+		return addLen, err
+	}
+
+	n, err := w.Write(con.Data)
+
+	return addLen + int64(n), err
+}
+
+// A LRawInd represents a L_Raw.ind message.
+type LRawInd struct {
+	// AddInfo is the additional information block prefixed to the frame.
+	AddInfo AddInfo
+
+	// Data is the raw frame as it was captured on the medium.
+	Data []byte
+}
+
+// MessageCode returns the message code for L_Raw.ind.
+func (LRawInd) MessageCode() MessageCode {
+	return LRawIndCode
+}
+
+// Size returns the packed size.
+func (ind LRawInd) Size() uint {
+	return ind.AddInfo.Size() + uint(len(ind.Data))
+}
+
+// Pack the message body into the buffer.
+func (ind LRawInd) Pack(buffer []byte) {
+	addLen := ind.AddInfo.Size()
+	ind.AddInfo.Pack(buffer[:addLen])
+	copy(buffer[addLen:], ind.Data)
+}
+
+// Unpack initializes the structure by parsing the given data.
+func (ind *LRawInd) Unpack(data []byte) (n uint, err error) {
+	n, err = ind.AddInfo.Unpack(data)
+	if err != nil {
+		return
+	}
+
+	rest := data[n:]
+	ind.Data = append(ind.Data[:0], rest...)
+	n += uint(len(rest))
+
+	return
+}
+
+// WriteTo serializes the structure and writes it to the given Writer.
+func (ind *LRawInd) WriteTo(w io.Writer) (int64, error) {
+	addLen, err := ind.AddInfo.WriteTo(w)
+	if err != nil {
+		return addLen, err
+	}
+
+	n, err := w.Write(ind.Data)
+
+	return addLen + int64(n), err
+}
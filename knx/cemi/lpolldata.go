@@ -0,0 +1,162 @@
+package cemi
+
+import "io"
+
+// LPollDataReq represents a L_Poll_Data.req message, which polls a group of
+// devices sharing PollGroupAddress for a data value.
+type LPollDataReq struct {
+	// AddInfo is the additional information block prefixed to the frame.
+	AddInfo AddInfo
+
+	// Control1 and Control2 carry the same control information as a
+	// L_Data.req (frame type, repeat, priority, address type, hop count, ...).
+	Control1 uint8
+	Control2 uint8
+
+	// Source is the individual address of the polling device.
+	Source uint16
+
+	// PollGroupAddress is the group address being polled.
+	PollGroupAddress uint16
+
+	// NoOfSlots is the number of poll data slots expected in the response.
+	NoOfSlots uint8
+}
+
+// MessageCode returns the message code for L_Poll_Data.req.
+func (LPollDataReq) MessageCode() MessageCode {
+	return LPollDataReqCode
+}
+
+// Size returns the packed size.
+func (req LPollDataReq) Size() uint {
+	return req.AddInfo.Size() + 7
+}
+
+// Pack the message body into the buffer.
+func (req LPollDataReq) Pack(buffer []byte) {
+	addLen := req.AddInfo.Size()
+	req.AddInfo.Pack(buffer[:addLen])
+
+	buffer = buffer[addLen:]
+	buffer[0] = req.Control1
+	buffer[1] = req.Control2
+	buffer[2] = byte(req.Source >> 8)
+	buffer[3] = byte(req.Source)
+	buffer[4] = byte(req.PollGroupAddress >> 8)
+	buffer[5] = byte(req.PollGroupAddress)
+	buffer[6] = req.NoOfSlots
+}
+
+// Unpack initializes the structure by parsing the given data.
+func (req *LPollDataReq) Unpack(data []byte) (n uint, err error) {
+	n, err = req.AddInfo.Unpack(data)
+	if err != nil {
+		return
+	}
+
+	rest := data[n:]
+	if len(rest) < 7 {
+		return n, errMgmtBufferTooShort
+	}
+
+	req.Control1 = rest[0]
+	req.Control2 = rest[1]
+	req.Source = uint16(rest[2])<<8 | uint16(rest[3])
+	req.PollGroupAddress = uint16(rest[4])<<8 | uint16(rest[5])
+	req.NoOfSlots = rest[6]
+	n += 7
+
+	return
+}
+
+// WriteTo serializes the structure and writes it to the given Writer.
+func (req *LPollDataReq) WriteTo(w io.Writer) (int64, error) {
+	buffer := make([]byte, req.Size())
+	req.Pack(buffer)
+	n, err := w.Write(buffer)
+
+	return int64(n), err
+}
+
+// LPollDataCon represents a L_Poll_Data.con message, which carries the poll
+// data slots collected in response to a L_Poll_Data.req.
+type LPollDataCon struct {
+	// AddInfo is the additional information block prefixed to the frame.
+	AddInfo AddInfo
+
+	// Control1 and Control2 carry the same control information as a
+	// L_Data.req (frame type, repeat, priority, address type, hop count, ...).
+	Control1 uint8
+	Control2 uint8
+
+	// Source is the individual address of the device that ran the poll.
+	Source uint16
+
+	// PollGroupAddress is the group address that was polled.
+	PollGroupAddress uint16
+
+	// NoOfSlots is the number of poll data slots that were expected.
+	NoOfSlots uint8
+
+	// PollData holds the concatenated poll data slots, one octet per slot.
+	PollData []byte
+}
+
+// MessageCode returns the message code for L_Poll_Data.con.
+func (LPollDataCon) MessageCode() MessageCode {
+	return LPollDataConCode
+}
+
+// Size returns the packed size.
+func (con LPollDataCon) Size() uint {
+	return con.AddInfo.Size() + 7 + uint(len(con.PollData))
+}
+
+// Pack the message body into the buffer.
+func (con LPollDataCon) Pack(buffer []byte) {
+	addLen := con.AddInfo.Size()
+	con.AddInfo.Pack(buffer[:addLen])
+
+	buffer = buffer[addLen:]
+	buffer[0] = con.Control1
+	buffer[1] = con.Control2
+	buffer[2] = byte(con.Source >> 8)
+	buffer[3] = byte(con.Source)
+	buffer[4] = byte(con.PollGroupAddress >> 8)
+	buffer[5] = byte(con.PollGroupAddress)
+	buffer[6] = con.NoOfSlots
+	copy(buffer[7:], con.PollData)
+}
+
+// Unpack initializes the structure by parsing the given data.
+func (con *LPollDataCon) Unpack(data []byte) (n uint, err error) {
+	n, err = con.AddInfo.Unpack(data)
+	if err != nil {
+		return
+	}
+
+	rest := data[n:]
+	if len(rest) < 7 {
+		return n, errMgmtBufferTooShort
+	}
+
+	con.Control1 = rest[0]
+	con.Control2 = rest[1]
+	con.Source = uint16(rest[2])<<8 | uint16(rest[3])
+	con.PollGroupAddress = uint16(rest[4])<<8 | uint16(rest[5])
+	con.NoOfSlots = rest[6]
+	con.PollData = append(con.PollData[:0], rest[7:]...)
+	n += uint(len(rest))
+
+	return
+}
+
+// WriteTo serializes the structure and writes it to the given Writer.
+func (con *LPollDataCon) WriteTo(w io.Writer) (int64, error) {
+	buffer := make([]byte, con.Size())
+	con.Pack(buffer)
+	n, err := w.Write(buffer)
+
+	return int64(n), err
+}
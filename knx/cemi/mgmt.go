@@ -0,0 +1,417 @@
+package cemi
+
+import (
+	"errors"
+	"io"
+)
+
+// errMgmtBufferTooShort is returned when a management message, or a
+// L_Poll_Data message, cannot be unpacked from the given buffer, because it is
+// too short.
+var errMgmtBufferTooShort = errors.New("cemi: buffer too short for management message")
+
+// packPropHeader packs the common interface object property header shared by
+// the M_Prop* and M_FuncPropCommand* services into buffer, which must be at
+// least 6 bytes long.
+func packPropHeader(
+	buffer []byte,
+	objType uint16,
+	objInstance, propID, numElements uint8,
+	startIndex uint16,
+) {
+	buffer[0] = byte(objType >> 8)
+	buffer[1] = byte(objType)
+	buffer[2] = objInstance
+	buffer[3] = propID
+	buffer[4] = (numElements << 4) | byte(startIndex>>8)
+	buffer[5] = byte(startIndex)
+}
+
+// unpackPropHeader unpacks the common interface object property header shared
+// by the M_Prop* and M_FuncPropCommand* services from data, which must be at
+// least 6 bytes long.
+func unpackPropHeader(data []byte) (objType uint16, objInstance, propID, numElements uint8, startIndex uint16) {
+	objType = uint16(data[0])<<8 | uint16(data[1])
+	objInstance = data[2]
+	propID = data[3]
+	numElements = data[4] >> 4
+	startIndex = uint16(data[4]&0x0F)<<8 | uint16(data[5])
+
+	return
+}
+
+// MPropReadReq represents a M_PropRead.req message, which requests the value
+// of a property of an interface object.
+type MPropReadReq struct {
+	ObjectType     uint16
+	ObjectInstance uint8
+	PropertyID     uint8
+	NumElements    uint8
+	StartIndex     uint16
+}
+
+// MessageCode returns the message code for M_PropRead.req.
+func (MPropReadReq) MessageCode() MessageCode {
+	return MPropReadReqCode
+}
+
+// Size returns the packed size.
+func (MPropReadReq) Size() uint {
+	return 6
+}
+
+// Pack the message body into the buffer.
+func (req MPropReadReq) Pack(buffer []byte) {
+	packPropHeader(buffer, req.ObjectType, req.ObjectInstance, req.PropertyID, req.NumElements, req.StartIndex)
+}
+
+// Unpack initializes the structure by parsing the given data.
+func (req *MPropReadReq) Unpack(data []byte) (uint, error) {
+	if len(data) < 6 {
+		return 0, errMgmtBufferTooShort
+	}
+
+	req.ObjectType, req.ObjectInstance, req.PropertyID, req.NumElements, req.StartIndex = unpackPropHeader(data)
+
+	return 6, nil
+}
+
+// WriteTo serializes the structure and writes it to the given Writer.
+func (req *MPropReadReq) WriteTo(w io.Writer) (int64, error) {
+	buffer := make([]byte, req.Size())
+	req.Pack(buffer)
+	n, err := w.Write(buffer)
+
+	return int64(n), err
+}
+
+// MPropReadCon represents a M_PropRead.con message, which carries the value
+// of a previously requested property, or an empty Data if the read failed.
+type MPropReadCon struct {
+	ObjectType     uint16
+	ObjectInstance uint8
+	PropertyID     uint8
+	NumElements    uint8
+	StartIndex     uint16
+	Data           []byte
+}
+
+// MessageCode returns the message code for M_PropRead.con.
+func (MPropReadCon) MessageCode() MessageCode {
+	return MPropReadConCode
+}
+
+// Size returns the packed size.
+func (con MPropReadCon) Size() uint {
+	return 6 + uint(len(con.Data))
+}
+
+// Pack the message body into the buffer.
+func (con MPropReadCon) Pack(buffer []byte) {
+	packPropHeader(buffer, con.ObjectType, con.ObjectInstance, con.PropertyID, con.NumElements, con.StartIndex)
+	copy(buffer[6:], con.Data)
+}
+
+// Unpack initializes the structure by parsing the given data.
+func (con *MPropReadCon) Unpack(data []byte) (uint, error) {
+	if len(data) < 6 {
+		return 0, errMgmtBufferTooShort
+	}
+
+	con.ObjectType, con.ObjectInstance, con.PropertyID, con.NumElements, con.StartIndex = unpackPropHeader(data)
+
+	con.Data = append([]byte(nil), data[6:]...)
+
+	return uint(len(data)), nil
+}
+
+// WriteTo serializes the structure and writes it to the given Writer.
+func (con *MPropReadCon) WriteTo(w io.Writer) (int64, error) {
+	buffer := make([]byte, con.Size())
+	con.Pack(buffer)
+	n, err := w.Write(buffer)
+
+	return int64(n), err
+}
+
+// MPropWriteReq represents a M_PropWrite.req message, which requests that a
+// property of an interface object be set to Data.
+type MPropWriteReq struct {
+	ObjectType     uint16
+	ObjectInstance uint8
+	PropertyID     uint8
+	NumElements    uint8
+	StartIndex     uint16
+	Data           []byte
+}
+
+// MessageCode returns the message code for M_PropWrite.req.
+func (MPropWriteReq) MessageCode() MessageCode {
+	return MPropWriteReqCode
+}
+
+// Size returns the packed size.
+func (req MPropWriteReq) Size() uint {
+	return 6 + uint(len(req.Data))
+}
+
+// Pack the message body into the buffer.
+func (req MPropWriteReq) Pack(buffer []byte) {
+	packPropHeader(buffer, req.ObjectType, req.ObjectInstance, req.PropertyID, req.NumElements, req.StartIndex)
+	copy(buffer[6:], req.Data)
+}
+
+// Unpack initializes the structure by parsing the given data.
+func (req *MPropWriteReq) Unpack(data []byte) (uint, error) {
+	if len(data) < 6 {
+		return 0, errMgmtBufferTooShort
+	}
+
+	req.ObjectType, req.ObjectInstance, req.PropertyID, req.NumElements, req.StartIndex = unpackPropHeader(data)
+
+	req.Data = append([]byte(nil), data[6:]...)
+
+	return uint(len(data)), nil
+}
+
+// WriteTo serializes the structure and writes it to the given Writer.
+func (req *MPropWriteReq) WriteTo(w io.Writer) (int64, error) {
+	buffer := make([]byte, req.Size())
+	req.Pack(buffer)
+	n, err := w.Write(buffer)
+
+	return int64(n), err
+}
+
+// MPropWriteCon represents a M_PropWrite.con message, which confirms a
+// previous M_PropWrite.req. Data is only present if the write failed, in
+// which case it carries a non-interface-object-property-service-specific
+// error code.
+type MPropWriteCon struct {
+	ObjectType     uint16
+	ObjectInstance uint8
+	PropertyID     uint8
+	NumElements    uint8
+	StartIndex     uint16
+	Data           []byte
+}
+
+// MessageCode returns the message code for M_PropWrite.con.
+func (MPropWriteCon) MessageCode() MessageCode {
+	return MPropWriteConCode
+}
+
+// Size returns the packed size.
+func (con MPropWriteCon) Size() uint {
+	return 6 + uint(len(con.Data))
+}
+
+// Pack the message body into the buffer.
+func (con MPropWriteCon) Pack(buffer []byte) {
+	packPropHeader(buffer, con.ObjectType, con.ObjectInstance, con.PropertyID, con.NumElements, con.StartIndex)
+	copy(buffer[6:], con.Data)
+}
+
+// Unpack initializes the structure by parsing the given data.
+func (con *MPropWriteCon) Unpack(data []byte) (uint, error) {
+	if len(data) < 6 {
+		return 0, errMgmtBufferTooShort
+	}
+
+	con.ObjectType, con.ObjectInstance, con.PropertyID, con.NumElements, con.StartIndex = unpackPropHeader(data)
+
+	con.Data = append([]byte(nil), data[6:]...)
+
+	return uint(len(data)), nil
+}
+
+// WriteTo serializes the structure and writes it to the given Writer.
+func (con *MPropWriteCon) WriteTo(w io.Writer) (int64, error) {
+	buffer := make([]byte, con.Size())
+	con.Pack(buffer)
+	n, err := w.Write(buffer)
+
+	return int64(n), err
+}
+
+// MPropInfoInd represents a M_PropInfo.ind message, which is sent
+// unsolicited whenever a property value changes.
+type MPropInfoInd struct {
+	ObjectType     uint16
+	ObjectInstance uint8
+	PropertyID     uint8
+	NumElements    uint8
+	StartIndex     uint16
+	Data           []byte
+}
+
+// MessageCode returns the message code for M_PropInfo.ind.
+func (MPropInfoInd) MessageCode() MessageCode {
+	return MPropInfoIndCode
+}
+
+// Size returns the packed size.
+func (ind MPropInfoInd) Size() uint {
+	return 6 + uint(len(ind.Data))
+}
+
+// Pack the message body into the buffer.
+func (ind MPropInfoInd) Pack(buffer []byte) {
+	packPropHeader(buffer, ind.ObjectType, ind.ObjectInstance, ind.PropertyID, ind.NumElements, ind.StartIndex)
+	copy(buffer[6:], ind.Data)
+}
+
+// Unpack initializes the structure by parsing the given data.
+func (ind *MPropInfoInd) Unpack(data []byte) (uint, error) {
+	if len(data) < 6 {
+		return 0, errMgmtBufferTooShort
+	}
+
+	ind.ObjectType, ind.ObjectInstance, ind.PropertyID, ind.NumElements, ind.StartIndex = unpackPropHeader(data)
+
+	ind.Data = append([]byte(nil), data[6:]...)
+
+	return uint(len(data)), nil
+}
+
+// WriteTo serializes the structure and writes it to the given Writer.
+func (ind *MPropInfoInd) WriteTo(w io.Writer) (int64, error) {
+	buffer := make([]byte, ind.Size())
+	ind.Pack(buffer)
+	n, err := w.Write(buffer)
+
+	return int64(n), err
+}
+
+// MFuncPropCommandReq represents a M_FuncPropCommand.req message, which
+// invokes a function property of an interface object.
+type MFuncPropCommandReq struct {
+	ObjectType     uint16
+	ObjectInstance uint8
+	PropertyID     uint8
+	Data           []byte
+}
+
+// MessageCode returns the message code for M_FuncPropCommand.req.
+func (MFuncPropCommandReq) MessageCode() MessageCode {
+	return MFuncPropCommandReqCode
+}
+
+// Size returns the packed size.
+func (req MFuncPropCommandReq) Size() uint {
+	return 4 + uint(len(req.Data))
+}
+
+// Pack the message body into the buffer.
+func (req MFuncPropCommandReq) Pack(buffer []byte) {
+	buffer[0] = byte(req.ObjectType >> 8)
+	buffer[1] = byte(req.ObjectType)
+	buffer[2] = req.ObjectInstance
+	buffer[3] = req.PropertyID
+	copy(buffer[4:], req.Data)
+}
+
+// Unpack initializes the structure by parsing the given data.
+func (req *MFuncPropCommandReq) Unpack(data []byte) (uint, error) {
+	if len(data) < 4 {
+		return 0, errMgmtBufferTooShort
+	}
+
+	req.ObjectType = uint16(data[0])<<8 | uint16(data[1])
+	req.ObjectInstance = data[2]
+	req.PropertyID = data[3]
+	req.Data = append([]byte(nil), data[4:]...)
+
+	return uint(len(data)), nil
+}
+
+// WriteTo serializes the structure and writes it to the given Writer.
+func (req *MFuncPropCommandReq) WriteTo(w io.Writer) (int64, error) {
+	buffer := make([]byte, req.Size())
+	req.Pack(buffer)
+	n, err := w.Write(buffer)
+
+	return int64(n), err
+}
+
+// MFuncPropCommandCon represents a M_FuncPropCommand.con message, which
+// carries the result of a previously invoked function property.
+type MFuncPropCommandCon struct {
+	ObjectType     uint16
+	ObjectInstance uint8
+	PropertyID     uint8
+	ReturnCode     uint8
+	Data           []byte
+}
+
+// MessageCode returns the message code for M_FuncPropCommand.con.
+func (MFuncPropCommandCon) MessageCode() MessageCode {
+	return MFuncPropCommandConCode
+}
+
+// Size returns the packed size.
+func (con MFuncPropCommandCon) Size() uint {
+	return 5 + uint(len(con.Data))
+}
+
+// Pack the message body into the buffer.
+func (con MFuncPropCommandCon) Pack(buffer []byte) {
+	buffer[0] = byte(con.ObjectType >> 8)
+	buffer[1] = byte(con.ObjectType)
+	buffer[2] = con.ObjectInstance
+	buffer[3] = con.PropertyID
+	buffer[4] = con.ReturnCode
+	copy(buffer[5:], con.Data)
+}
+
+// Unpack initializes the structure by parsing the given data.
+func (con *MFuncPropCommandCon) Unpack(data []byte) (uint, error) {
+	if len(data) < 5 {
+		return 0, errMgmtBufferTooShort
+	}
+
+	con.ObjectType = uint16(data[0])<<8 | uint16(data[1])
+	con.ObjectInstance = data[2]
+	con.PropertyID = data[3]
+	con.ReturnCode = data[4]
+	con.Data = append([]byte(nil), data[5:]...)
+
+	return uint(len(data)), nil
+}
+
+// WriteTo serializes the structure and writes it to the given Writer.
+func (con *MFuncPropCommandCon) WriteTo(w io.Writer) (int64, error) {
+	buffer := make([]byte, con.Size())
+	con.Pack(buffer)
+	n, err := w.Write(buffer)
+
+	return int64(n), err
+}
+
+// MReset represents a M_Reset message. It carries no data; M_Reset.req and
+// M_Reset.ind share the same message code and are distinguished only by the
+// direction of transmission.
+type MReset struct{}
+
+// MessageCode returns the message code for M_Reset.
+func (MReset) MessageCode() MessageCode {
+	return MResetReqCode
+}
+
+// Size returns the packed size.
+func (MReset) Size() uint {
+	return 0
+}
+
+// Pack the message body into the buffer.
+func (MReset) Pack([]byte) {}
+
+// Unpack initializes the structure by parsing the given data.
+func (*MReset) Unpack(data []byte) (uint, error) {
+	return 0, nil
+}
+
+// WriteTo serializes the structure and writes it to the given Writer.
+func (*MReset) WriteTo(w io.Writer) (int64, error) {
+	return 0, nil
+}
@@ -0,0 +1,99 @@
+package knx
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vapourismo/knx-go/knx/cemi"
+)
+
+// A PropertyTunnel is a tunnel connection that can exchange cEMI Device
+// Management messages with a KNXnet/IP server, such as a Tunnel.
+type PropertyTunnel interface {
+	Send(message cemi.Message) error
+	Inbound() <-chan cemi.Message
+}
+
+// RequestProperty sends a M_PropRead.req for the given interface object
+// property over conn and waits for the matching M_PropRead.con. It blocks
+// until a response arrives or ctx is done.
+func RequestProperty(
+	ctx context.Context,
+	conn PropertyTunnel,
+	objType uint16,
+	objInstance, propID uint8,
+) (*cemi.MPropReadCon, error) {
+	req := &cemi.MPropReadReq{
+		ObjectType:     objType,
+		ObjectInstance: objInstance,
+		PropertyID:     propID,
+		NumElements:    1,
+		StartIndex:     1,
+	}
+
+	if err := conn.Send(req); err != nil {
+		return nil, err
+	}
+
+	for {
+		select {
+		case msg, open := <-conn.Inbound():
+			if !open {
+				return nil, fmt.Errorf("knx: tunnel closed while waiting for M_PropRead.con")
+			}
+
+			con, ok := msg.(*cemi.MPropReadCon)
+			if !ok || con.ObjectType != objType || con.ObjectInstance != objInstance || con.PropertyID != propID {
+				continue
+			}
+
+			return con, nil
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// WriteProperty sends a M_PropWrite.req for the given interface object
+// property over conn and waits for the matching M_PropWrite.con. It blocks
+// until a response arrives or ctx is done.
+func WriteProperty(
+	ctx context.Context,
+	conn PropertyTunnel,
+	objType uint16,
+	objInstance, propID uint8,
+	data []byte,
+) (*cemi.MPropWriteCon, error) {
+	req := &cemi.MPropWriteReq{
+		ObjectType:     objType,
+		ObjectInstance: objInstance,
+		PropertyID:     propID,
+		NumElements:    1,
+		StartIndex:     1,
+		Data:           data,
+	}
+
+	if err := conn.Send(req); err != nil {
+		return nil, err
+	}
+
+	for {
+		select {
+		case msg, open := <-conn.Inbound():
+			if !open {
+				return nil, fmt.Errorf("knx: tunnel closed while waiting for M_PropWrite.con")
+			}
+
+			con, ok := msg.(*cemi.MPropWriteCon)
+			if !ok || con.ObjectType != objType || con.ObjectInstance != objInstance || con.PropertyID != propID {
+				continue
+			}
+
+			return con, nil
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}